@@ -2,6 +2,10 @@ package main
 
 import (
 	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	"github.com/confluentinc/confluent-kafka-go/kafka"
 )
@@ -18,9 +22,21 @@ func main() {
 	consumer.Subscribe("foo", nil)
 	defer consumer.Close()
 
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+
 	for {
-		message, err := consumer.ReadMessage(-1)
+		select {
+		case sig := <-signals:
+			fmt.Println("terminating:", sig)
+			return
+		default:
+		}
+		message, err := consumer.ReadMessage(100 * time.Millisecond)
 		if err != nil {
+			if kafkaErr, ok := err.(kafka.Error); ok && kafkaErr.Code() == kafka.ErrTimedOut {
+				continue
+			}
 			fmt.Printf("Consumer error: %v (%v)\n", err, message)
 			continue
 		}
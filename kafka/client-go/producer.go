@@ -3,6 +3,9 @@ package main
 import (
 	"fmt"
 	"math/rand"
+	"os"
+	"os/signal"
+	"syscall"
 	"time"
 
 	"github.com/confluentinc/confluent-kafka-go/kafka"
@@ -20,6 +23,9 @@ func main() {
 	defer producer.Close()
 	defer producer.Flush(5000)
 
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM)
+
 	for {
 		topic := "foo"
 		nextMessage := time.Now().Format(time.UnixDate)[11:19]
@@ -30,6 +36,11 @@ func main() {
 				Key:            []byte("golang"), Value: []byte(nextMessage)},
 			nil,
 		)
-		time.Sleep(time.Duration(1+9*rand.Float64()) * time.Second)
+		select {
+		case sig := <-signals:
+			fmt.Println("terminating:", sig)
+			return
+		case <-time.After(time.Duration(1+9*rand.Float64()) * time.Second):
+		}
 	}
 }